@@ -0,0 +1,140 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package containerlog captures the combined stdout/stderr of individual
+// Docker containers into per-container log files, so a failing benchmark
+// doesn't leave users guessing which service produced which line.
+package containerlog
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// Dir is the name of the subdirectory (relative to a test run's output
+// directory) that per-container log files are written to.
+const Dir = "logs"
+
+// Capture streams the combined stdout/stderr of a single container into a
+// dedicated log file, demultiplexing the Docker log stream protocol so lines
+// from stdout and stderr both land in the file in the order Docker delivered
+// them.
+type Capture struct {
+	Name string // Name is the container name, used to derive the log file name.
+	Path string // Path is the log file this capture writes to.
+
+	client      client.APIClient
+	containerID string
+	file        *os.File
+}
+
+// New creates a Capture for the given container, writing its combined log
+// output to <dir>/<name>.log. The caller is responsible for closing it, and
+// for creating dir beforehand: New fails if dir does not exist.
+func New(cli client.APIClient, containerID, name, dir string) (*Capture, error) {
+	path := filepath.Join(dir, name+".log")
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log file %q: %w", path, err)
+	}
+
+	return &Capture{
+		Name:        name,
+		Path:        path,
+		client:      cli,
+		containerID: containerID,
+		file:        f,
+	}, nil
+}
+
+// Run opens a streaming ContainerLogs call and demultiplexes it into the
+// capture's log file until ctx is canceled or the stream ends. Run blocks
+// and is meant to be launched through testRun.Go, so a stream failure is
+// surfaced through the usual goroutine pool.
+func (c *Capture) Run(ctx context.Context) error {
+	rc, err := c.client.ContainerLogs(ctx, c.containerID, container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Timestamps: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open log stream for %s: %w", c.Name, err)
+	}
+	defer rc.Close()
+
+	if err := Demux(c.file, rc); err != nil {
+		return fmt.Errorf("failed to capture logs for %s: %w", c.Name, err)
+	}
+	return nil
+}
+
+// Close closes the underlying log file. It is safe to call even if Run
+// never completed.
+func (c *Capture) Close() error {
+	return c.file.Close()
+}
+
+// Tail returns the last n lines written to the capture's log file so far.
+func (c *Capture) Tail(n int) ([]string, error) {
+	f, err := os.Open(c.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file %q: %w", c.Path, err)
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read log file %q: %w", c.Path, err)
+	}
+
+	return lines, nil
+}
+
+// Demux reads Docker's multiplexed log/exec stream from r (8-byte header
+// frames: 1 byte stream type, 3 bytes padding, 4 byte big-endian payload
+// size) and writes the payloads to w in the order they arrive.
+func Demux(w io.Writer, r io.Reader) error {
+	header := make([]byte, 8)
+	for {
+		_, err := io.ReadFull(r, header)
+		if err != nil {
+			if err == io.EOF { //nolint:errorlint // io.ReadFull returns io.EOF verbatim when no bytes were read.
+				return nil
+			}
+			return fmt.Errorf("failed to read stream header: %w", err)
+		}
+
+		size := binary.BigEndian.Uint32(header[4:8])
+		if _, err := io.CopyN(w, r, int64(size)); err != nil {
+			return fmt.Errorf("failed to copy stream payload: %w", err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func frame(t *testing.T, payload string) []byte {
+	t.Helper()
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+func TestDemux(t *testing.T) {
+	var stream bytes.Buffer
+	stream.Write(frame(t, "hello "))
+	stream.Write(frame(t, "world\n"))
+
+	var out bytes.Buffer
+	// OneByteReader forces io.ReadFull/io.CopyN to assemble each frame from
+	// many short reads, exercising the partial-read path.
+	if err := Demux(&out, iotest.OneByteReader(&stream)); err != nil {
+		t.Fatalf("Demux returned error: %v", err)
+	}
+
+	if got, want := out.String(), "hello world\n"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestDemuxEmptyStream(t *testing.T) {
+	var out bytes.Buffer
+	if err := Demux(&out, strings.NewReader("")); err != nil {
+		t.Fatalf("Demux returned error: %v", err)
+	}
+	if out.Len() != 0 {
+		t.Fatalf("expected no output, got %q", out.String())
+	}
+}
+
+func TestDemuxTruncatedHeader(t *testing.T) {
+	var out bytes.Buffer
+	// A header cut short mid-frame is a malformed stream, not a clean EOF.
+	err := Demux(&out, bytes.NewReader(frame(t, "hello")[:4]))
+	if err == nil {
+		t.Fatal("expected an error for a truncated header, got nil")
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dockerstats implements a metrics collector that reads CPU, memory,
+// network and block IO usage straight from the Docker stats API, for tools
+// that don't expose a /metrics endpoint of their own.
+package dockerstats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/conduitio/benchi/metrics"
+	"github.com/conduitio/benchi/results"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+const Type = "docker-stats"
+
+// Register registers the Docker stats collector with the metrics system.
+// This function should be called explicitly by the application.
+func Register() {
+	metrics.RegisterCollector(NewCollector)
+}
+
+// Collector streams the Docker stats API for every container matching its
+// configured name patterns and records CPU, memory, network and block IO
+// usage as named series.
+type Collector struct {
+	logger *slog.Logger
+	name   string
+	cfg    Config
+	client client.APIClient
+
+	mu         sync.Mutex
+	series     map[string][]results.Sample
+	lastSample map[string]time.Time // container name -> time of its last recorded sample
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewCollector(logger *slog.Logger, name string) *Collector {
+	return &Collector{
+		logger:     logger,
+		name:       name,
+		series:     make(map[string][]results.Sample),
+		lastSample: make(map[string]time.Time),
+	}
+}
+
+func (c *Collector) Type() string {
+	return Type
+}
+
+func (c *Collector) Configure(settings map[string]any) error {
+	cfg, err := parseConfig(settings)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+	c.cfg = cfg
+	return nil
+}
+
+// SetDockerClient gives the collector the Docker client it needs to stream
+// stats. It is called by the runner before Start.
+func (c *Collector) SetDockerClient(cli client.APIClient) {
+	c.client = cli
+}
+
+// Start resolves the configured container patterns against the currently
+// running containers and starts a stats stream for each match.
+func (c *Collector) Start(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	c.mu.Lock()
+	c.series = make(map[string][]results.Sample)
+	c.lastSample = make(map[string]time.Time)
+	c.mu.Unlock()
+
+	ids, err := c.matchContainers(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			c.stream(ctx, id)
+		}(id)
+	}
+
+	go func() {
+		wg.Wait()
+		close(c.done)
+	}()
+
+	return nil
+}
+
+// Stop cancels every running stats stream and waits for them to exit.
+func (c *Collector) Stop(ctx context.Context) error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	if c.done == nil {
+		return nil
+	}
+
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck // ctx.Err() is returned verbatim by convention.
+	}
+}
+
+// Samples returns the recorded series, implementing results.SeriesProvider.
+func (c *Collector) Samples() []results.Series {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	series := make([]results.Series, 0, len(c.series))
+	for name, samples := range c.series {
+		series = append(series, results.Series{Name: name, Unit: unitFor(name), Samples: samples})
+	}
+	return series
+}
+
+func unitFor(name string) string {
+	switch {
+	case strings.HasPrefix(name, "cpu-percent"):
+		return "%"
+	case strings.HasPrefix(name, "mem-bytes"), strings.Contains(name, "-bytes"):
+		return "bytes"
+	default:
+		return ""
+	}
+}
+
+func (c *Collector) matchContainers(ctx context.Context) ([]string, error) {
+	list, err := c.client.ContainerList(ctx, container.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	var ids []string
+	for _, ctr := range list {
+		name := containerName(ctr.Names)
+		for _, pattern := range c.cfg.Containers {
+			if ok, _ := path.Match(pattern, name); ok {
+				ids = append(ids, ctr.ID)
+				break
+			}
+		}
+	}
+	return ids, nil
+}
+
+func containerName(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(names[0], "/")
+}
+
+func (c *Collector) stream(ctx context.Context, id string) {
+	resp, err := c.client.ContainerStats(ctx, id, true)
+	if err != nil {
+		c.logger.Error("Failed to open stats stream", "container", id, "error", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(resp.Body)
+	for {
+		var stats container.StatsResponse
+		if err := dec.Decode(&stats); err != nil {
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				return
+			}
+			c.logger.Error("Failed to decode container stats", "container", id, "error", err)
+			return
+		}
+		c.record(stats)
+	}
+}
+
+func (c *Collector) record(s container.StatsResponse) {
+	name := strings.TrimPrefix(s.Name, "/")
+
+	c.mu.Lock()
+	if last, ok := c.lastSample[name]; ok && s.Read.Sub(last) < c.cfg.Interval {
+		c.mu.Unlock()
+		return
+	}
+	c.lastSample[name] = s.Read
+	c.mu.Unlock()
+
+	var rx, tx float64
+	for _, n := range s.Networks {
+		rx += float64(n.RxBytes)
+		tx += float64(n.TxBytes)
+	}
+
+	var ioRead, ioWrite float64
+	for _, e := range s.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(e.Op) {
+		case "read":
+			ioRead += float64(e.Value)
+		case "write":
+			ioWrite += float64(e.Value)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.append(fmt.Sprintf("cpu-percent[%s]", name), s.Read, cpuPercent(s))
+	c.append(fmt.Sprintf("mem-bytes[%s]", name), s.Read, memUsage(s))
+	c.append(fmt.Sprintf("net-rx-bytes[%s]", name), s.Read, rx)
+	c.append(fmt.Sprintf("net-tx-bytes[%s]", name), s.Read, tx)
+	c.append(fmt.Sprintf("io-read-bytes[%s]", name), s.Read, ioRead)
+	c.append(fmt.Sprintf("io-write-bytes[%s]", name), s.Read, ioWrite)
+}
+
+func (c *Collector) append(name string, t time.Time, v float64) {
+	c.series[name] = append(c.series[name], results.Sample{T: t, V: v})
+}
+
+// cpuPercent computes the container's CPU usage over the sampling window as
+// a percentage of a single CPU: 100% is one fully used CPU, so the value can
+// reach the number of online CPUs times 100.
+func cpuPercent(s container.StatsResponse) float64 {
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage) - float64(s.PreCPUStats.CPUUsage.TotalUsage)
+	sysDelta := float64(s.CPUStats.SystemUsage) - float64(s.PreCPUStats.SystemUsage)
+	if sysDelta <= 0 || cpuDelta <= 0 {
+		return 0
+	}
+	return (cpuDelta / sysDelta) * float64(s.CPUStats.OnlineCPUs) * 100
+}
+
+// memUsage returns the container's memory usage with the page cache
+// excluded, matching what `docker stats` reports.
+func memUsage(s container.StatsResponse) float64 {
+	return float64(s.MemoryStats.Usage) - float64(s.MemoryStats.Stats["cache"])
+}
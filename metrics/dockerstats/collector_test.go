@@ -0,0 +1,57 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerstats
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+)
+
+func TestCpuPercent(t *testing.T) {
+	s := container.StatsResponse{}
+	s.CPUStats.CPUUsage.TotalUsage = 200
+	s.PreCPUStats.CPUUsage.TotalUsage = 100
+	s.CPUStats.SystemUsage = 1100
+	s.PreCPUStats.SystemUsage = 1000
+	s.CPUStats.OnlineCPUs = 2
+
+	// cpuDelta=100, sysDelta=100 -> (100/100) * 2 * 100 = 200%
+	if got, want := cpuPercent(s), 200.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestCpuPercent_NoDelta(t *testing.T) {
+	s := container.StatsResponse{}
+	s.CPUStats.CPUUsage.TotalUsage = 100
+	s.PreCPUStats.CPUUsage.TotalUsage = 100
+	s.CPUStats.SystemUsage = 1100
+	s.PreCPUStats.SystemUsage = 1000
+
+	if got, want := cpuPercent(s), 0.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestMemUsage(t *testing.T) {
+	s := container.StatsResponse{}
+	s.MemoryStats.Usage = 1000
+	s.MemoryStats.Stats = map[string]uint64{"cache": 400}
+
+	if got, want := memUsage(s), 600.0; got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
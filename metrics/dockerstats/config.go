@@ -0,0 +1,59 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerstats
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+var defaultConfig = Config{
+	Interval: time.Second,
+}
+
+type Config struct {
+	// Containers is a list of container name patterns (as matched by
+	// path.Match) to collect stats from.
+	Containers []string `yaml:"containers"`
+	// Interval is the time between stats samples (defaults to 1s).
+	Interval time.Duration `yaml:"interval"`
+}
+
+func parseConfig(settings map[string]any) (Config, error) {
+	cfg := defaultConfig
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		Result:           &cfg,
+		TagName:          "yaml",
+	})
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to create decoder: %w", err)
+	}
+
+	err = dec.Decode(settings)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to decode settings: %w", err)
+	}
+
+	if len(cfg.Containers) == 0 {
+		return Config{}, fmt.Errorf("at least one container pattern is required")
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,230 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus implements a metrics collector that periodically runs
+// PromQL queries against a Prometheus HTTP API endpoint.
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/conduitio/benchi/results"
+)
+
+const Type = "prometheus"
+
+// Collector periodically runs a set of PromQL queries against a Prometheus
+// HTTP API endpoint and records each query's result as a named time series.
+type Collector struct {
+	logger     *slog.Logger
+	name       string
+	cfg        Config
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	series map[string][]results.Sample
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewCollector(logger *slog.Logger, name string) *Collector {
+	return &Collector{
+		logger:     logger,
+		name:       name,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		series:     make(map[string][]results.Sample),
+	}
+}
+
+func (c *Collector) Type() string {
+	return Type
+}
+
+func (c *Collector) Configure(settings map[string]any) error {
+	cfg, err := parseConfig(settings)
+	if err != nil {
+		return fmt.Errorf("failed to parse config: %w", err)
+	}
+	c.cfg = cfg
+	return nil
+}
+
+// Start launches one scrape loop per configured query, each running at its
+// own interval (falling back to the collector's ScrapeInterval).
+func (c *Collector) Start(ctx context.Context) error {
+	base, err := c.cfg.parseURL()
+	if err != nil {
+		return fmt.Errorf("failed to parse URL: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	c.mu.Lock()
+	c.series = make(map[string][]results.Sample)
+	c.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, q := range c.cfg.Queries {
+		interval := q.Interval
+		if interval <= 0 {
+			interval = c.cfg.ScrapeInterval
+		}
+
+		wg.Add(1)
+		go func(q QueryConfig, interval time.Duration) {
+			defer wg.Done()
+			c.scrape(ctx, base, q, interval)
+		}(q, interval)
+	}
+
+	go func() {
+		wg.Wait()
+		close(c.done)
+	}()
+
+	return nil
+}
+
+// Stop cancels every running scrape loop and waits for them to exit.
+func (c *Collector) Stop(ctx context.Context) error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	if c.done == nil {
+		return nil
+	}
+
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck // ctx.Err() is returned verbatim by convention.
+	}
+}
+
+// Samples returns the series recorded for every configured query,
+// implementing results.SeriesProvider.
+func (c *Collector) Samples() []results.Series {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	series := make([]results.Series, 0, len(c.series))
+	for name, samples := range c.series {
+		series = append(series, results.Series{Name: name, Unit: c.unitFor(name), Samples: samples})
+	}
+	return series
+}
+
+func (c *Collector) unitFor(name string) string {
+	for _, q := range c.cfg.Queries {
+		if q.Name == name {
+			return q.Unit
+		}
+	}
+	return ""
+}
+
+func (c *Collector) scrape(ctx context.Context, base *url.URL, q QueryConfig, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		t, v, err := c.query(ctx, base, q.QueryString)
+		if err != nil {
+			c.logger.Debug("Query failed", "query", q.Name, "error", err)
+			continue
+		}
+
+		c.mu.Lock()
+		c.series[q.Name] = append(c.series[q.Name], results.Sample{T: t, V: v})
+		c.mu.Unlock()
+	}
+}
+
+// query runs an instant query against the Prometheus HTTP API and returns
+// its first result's timestamp and value.
+func (c *Collector) query(ctx context.Context, base *url.URL, promQL string) (time.Time, float64, error) {
+	u := *base
+	u.Path = "/api/v1/query"
+	qs := u.Query()
+	qs.Set("query", promQL)
+	u.RawQuery = qs.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed queryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if parsed.Status != "success" {
+		return time.Time{}, 0, errors.New("query returned non-success status")
+	}
+	if len(parsed.Data.Result) == 0 || len(parsed.Data.Result[0].Value) != 2 {
+		return time.Time{}, 0, errors.New("query returned no samples")
+	}
+
+	ts, ok := parsed.Data.Result[0].Value[0].(float64)
+	if !ok {
+		return time.Time{}, 0, errors.New("unexpected timestamp type in query result")
+	}
+	val, ok := parsed.Data.Result[0].Value[1].(string)
+	if !ok {
+		return time.Time{}, 0, errors.New("unexpected value type in query result")
+	}
+	v, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("failed to parse value %q: %w", val, err)
+	}
+
+	return time.Unix(int64(ts), 0), v, nil
+}
+
+// queryResponse mirrors the relevant subset of Prometheus's HTTP API
+// instant-query response:
+// https://prometheus.io/docs/prometheus/latest/querying/api/#instant-queries
+type queryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Value []any `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
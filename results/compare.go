@@ -0,0 +1,104 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Delta is the percentage change of each stat between a baseline summary row
+// and the corresponding row in the current run.
+type Delta struct {
+	Test          string
+	Tool          string
+	Series        string
+	Unit          string
+	Baseline      Stats
+	Current       Stats
+	PercentChange Stats
+}
+
+// LoadBaseline reads a summary.json previously written by WriteSummary.
+func LoadBaseline(path string) ([]Summary, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline %q: %w", path, err)
+	}
+
+	var summaries []Summary
+	if err := json.Unmarshal(b, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %q: %w", path, err)
+	}
+
+	return summaries, nil
+}
+
+// Compare computes percentage deltas between a baseline and the current
+// summaries, matched by test, tool and series name. Rows without a matching
+// baseline entry are skipped.
+func Compare(baseline, current []Summary) []Delta {
+	baseRows := map[string]SummaryRow{}
+	for _, s := range baseline {
+		for _, row := range s.Rows {
+			baseRows[compareKey(s.Test, row.Tool, row.Series)] = row
+		}
+	}
+
+	var deltas []Delta
+	for _, s := range current {
+		for _, row := range s.Rows {
+			base, ok := baseRows[compareKey(s.Test, row.Tool, row.Series)]
+			if !ok {
+				continue
+			}
+
+			deltas = append(deltas, Delta{
+				Test:          s.Test,
+				Tool:          row.Tool,
+				Series:        row.Series,
+				Unit:          row.Unit,
+				Baseline:      base.Stats,
+				Current:       row.Stats,
+				PercentChange: percentChange(base.Stats, row.Stats),
+			})
+		}
+	}
+
+	return deltas
+}
+
+func compareKey(test, tool, series string) string {
+	return test + "/" + tool + "/" + series
+}
+
+func percentChange(base, cur Stats) Stats {
+	return Stats{
+		Min: pct(base.Min, cur.Min),
+		Max: pct(base.Max, cur.Max),
+		Avg: pct(base.Avg, cur.Avg),
+		P50: pct(base.P50, cur.P50),
+		P95: pct(base.P95, cur.P95),
+		P99: pct(base.P99, cur.P99),
+	}
+}
+
+func pct(base, cur float64) float64 {
+	if base == 0 {
+		return 0
+	}
+	return (cur - base) / base * 100
+}
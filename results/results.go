@@ -0,0 +1,132 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package results turns the time series collected by metrics collectors
+// during a test run into machine-readable records (results.json,
+// results.csv) and human-readable comparison reports (summary.md,
+// summary.html) across runs.
+package results
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// Sample is a single timestamped metric value.
+type Sample struct {
+	T time.Time `json:"t"`
+	V float64   `json:"v"`
+}
+
+// Series is a named, unit-tagged time series collected during a test run.
+type Series struct {
+	Name    string   `json:"name"`
+	Unit    string   `json:"unit"`
+	Samples []Sample `json:"samples"`
+}
+
+// SeriesProvider is implemented by metrics collectors that can report the
+// full time series they recorded during a test run. The Prometheus collector
+// (and anything built on top of it, like the Kafka collector) implements it
+// via a Samples method.
+type SeriesProvider interface {
+	Samples() []Series
+}
+
+// Meta carries the run metadata that isn't derived from the collected
+// metrics themselves.
+type Meta struct {
+	Test      string
+	Tool      string
+	StartedAt time.Time
+	Duration  time.Duration
+}
+
+// Run is the canonical, machine-readable record of a single test run,
+// written to <OutPath>/results.json and results.csv.
+type Run struct {
+	Test      string        `json:"test"`
+	Tool      string        `json:"tool"`
+	StartedAt time.Time     `json:"started-at"`
+	Duration  time.Duration `json:"duration"`
+	Series    []Series      `json:"series"`
+}
+
+// Write queries every collector for its recorded series and writes
+// results.json and results.csv into dir.
+func Write(dir string, meta Meta, collectors []SeriesProvider) (*Run, error) {
+	run := &Run{
+		Test:      meta.Test,
+		Tool:      meta.Tool,
+		StartedAt: meta.StartedAt,
+		Duration:  meta.Duration,
+	}
+
+	for _, c := range collectors {
+		run.Series = append(run.Series, c.Samples()...)
+	}
+
+	if err := writeJSON(filepath.Join(dir, "results.json"), run); err != nil {
+		return nil, err
+	}
+	if err := writeCSV(filepath.Join(dir, "results.csv"), run); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+func writeJSON(path string, run *Run) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %w", path, err)
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(run); err != nil {
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+	return nil
+}
+
+func writeCSV(path string, run *Run) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %w", path, err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	defer w.Flush()
+
+	if err := w.Write([]string{"series", "unit", "time", "value"}); err != nil {
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+	for _, s := range run.Series {
+		for _, sample := range s.Samples {
+			row := []string{s.Name, s.Unit, sample.T.Format(time.RFC3339Nano), strconv.FormatFloat(sample.V, 'f', -1, 64)}
+			if err := w.Write(row); err != nil {
+				return fmt.Errorf("failed to write %q: %w", path, err)
+			}
+		}
+	}
+	return nil
+}
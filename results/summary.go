@@ -0,0 +1,167 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Stats holds the aggregate statistics computed for a single series.
+type Stats struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+	Avg float64 `json:"avg"`
+	P50 float64 `json:"p50"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+}
+
+func computeStats(samples []Sample) Stats {
+	if len(samples) == 0 {
+		return Stats{}
+	}
+
+	values := make([]float64, len(samples))
+	var sum float64
+	for i, s := range samples {
+		values[i] = s.V
+		sum += s.V
+	}
+	sort.Float64s(values)
+
+	return Stats{
+		Min: values[0],
+		Max: values[len(values)-1],
+		Avg: sum / float64(len(values)),
+		P50: percentile(values, 0.50),
+		P95: percentile(values, 0.95),
+		P99: percentile(values, 0.99),
+	}
+}
+
+func percentile(sorted []float64, p float64) float64 {
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+// SummaryRow is a single tool/series combination with its aggregate stats,
+// for a single test.
+type SummaryRow struct {
+	Tool   string `json:"tool"`
+	Series string `json:"series"`
+	Unit   string `json:"unit"`
+	Stats  Stats  `json:"stats"`
+}
+
+// Summary compares the runs of a single test across tools, one row per
+// tool per series.
+type Summary struct {
+	Test string       `json:"test"`
+	Rows []SummaryRow `json:"rows"`
+}
+
+// BuildSummaries groups runs by test name and computes per-series stats for
+// each tool, preserving the order tests were first seen in.
+func BuildSummaries(runs []*Run) []Summary {
+	byTest := map[string][]*Run{}
+	var order []string
+	for _, r := range runs {
+		if _, ok := byTest[r.Test]; !ok {
+			order = append(order, r.Test)
+		}
+		byTest[r.Test] = append(byTest[r.Test], r)
+	}
+
+	summaries := make([]Summary, 0, len(order))
+	for _, test := range order {
+		var rows []SummaryRow
+		for _, r := range byTest[test] {
+			for _, s := range r.Series {
+				rows = append(rows, SummaryRow{
+					Tool:   r.Tool,
+					Series: s.Name,
+					Unit:   s.Unit,
+					Stats:  computeStats(s.Samples),
+				})
+			}
+		}
+		summaries = append(summaries, Summary{Test: test, Rows: rows})
+	}
+
+	return summaries
+}
+
+// WriteSummary renders the summaries as summary.md and summary.html, and
+// writes summary.json so a later run can use it as a CompareWith baseline.
+func WriteSummary(dir string, summaries []Summary) error {
+	if err := os.WriteFile(filepath.Join(dir, "summary.md"), []byte(renderMarkdown(summaries)), 0o644); err != nil {
+		return fmt.Errorf("failed to write summary.md: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "summary.html"), []byte(renderHTML(summaries)), 0o644); err != nil {
+		return fmt.Errorf("failed to write summary.html: %w", err)
+	}
+
+	b, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary.json: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "summary.json"), b, 0o644); err != nil {
+		return fmt.Errorf("failed to write summary.json: %w", err)
+	}
+
+	return nil
+}
+
+func renderMarkdown(summaries []Summary) string {
+	var buf bytes.Buffer
+	for _, s := range summaries {
+		fmt.Fprintf(&buf, "## %s\n\n", s.Test)
+		buf.WriteString("| Tool | Series | Unit | Min | Max | Avg | P50 | P95 | P99 |\n")
+		buf.WriteString("|------|--------|------|-----|-----|-----|-----|-----|-----|\n")
+		for _, row := range s.Rows {
+			fmt.Fprintf(&buf, "| %s | %s | %s | %.2f | %.2f | %.2f | %.2f | %.2f | %.2f |\n",
+				row.Tool, row.Series, row.Unit, row.Stats.Min, row.Stats.Max, row.Stats.Avg, row.Stats.P50, row.Stats.P95, row.Stats.P99)
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func renderHTML(summaries []Summary) string {
+	var buf bytes.Buffer
+	buf.WriteString("<html>\n<body>\n")
+	for _, s := range summaries {
+		fmt.Fprintf(&buf, "<h2>%s</h2>\n<table border=\"1\">\n", html.EscapeString(s.Test))
+		buf.WriteString("<tr><th>Tool</th><th>Series</th><th>Unit</th><th>Min</th><th>Max</th><th>Avg</th><th>P50</th><th>P95</th><th>P99</th></tr>\n")
+		for _, row := range s.Rows {
+			fmt.Fprintf(&buf, "<tr><td>%s</td><td>%s</td><td>%s</td><td>%.2f</td><td>%.2f</td><td>%.2f</td><td>%.2f</td><td>%.2f</td><td>%.2f</td></tr>\n",
+				html.EscapeString(row.Tool), html.EscapeString(row.Series), html.EscapeString(row.Unit),
+				row.Stats.Min, row.Stats.Max, row.Stats.Avg, row.Stats.P50, row.Stats.P95, row.Stats.P99)
+		}
+		buf.WriteString("</table>\n")
+	}
+	buf.WriteString("</body>\n</html>\n")
+	return buf.String()
+}
@@ -0,0 +1,50 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package results
+
+import "testing"
+
+func TestComputeStats_Empty(t *testing.T) {
+	if got, want := computeStats(nil), (Stats{}); got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestComputeStats(t *testing.T) {
+	samples := []Sample{{V: 5}, {V: 1}, {V: 3}, {V: 2}, {V: 4}}
+
+	got := computeStats(samples)
+
+	want := Stats{Min: 1, Max: 5, Avg: 3, P50: 3, P95: 5, P99: 5}
+	if got != want {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{10, 20, 30, 40, 50}
+
+	tests := map[float64]float64{
+		0:    10,
+		0.50: 30,
+		0.99: 50,
+	}
+
+	for p, want := range tests {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("percentile(%v, %v) = %v, want %v", sorted, p, got, want)
+		}
+	}
+}
@@ -16,6 +16,7 @@ package benchi
 
 import (
 	"bytes"
+	"container/heap"
 	"context"
 	"errors"
 	"fmt"
@@ -25,11 +26,16 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/conduitio/benchi/config"
+	"github.com/conduitio/benchi/containerlog"
 	"github.com/conduitio/benchi/dockerutil"
+	"github.com/conduitio/benchi/results"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 	"github.com/sourcegraph/conc/pool"
 )
@@ -40,6 +46,24 @@ type RunOptions struct {
 	OutPath      string
 	FilterTests  []string
 	DockerClient client.APIClient
+
+	// TailOnError is the number of lines of each container's log copied into
+	// the test run's logger when a step fails, for fast triage. 0 disables it.
+	TailOnError int
+
+	// CompareWith is the path to an older summary.json. If set, Run prints
+	// the percentage delta of this run's stats against that baseline.
+	CompareWith string
+
+	// Parallelism bounds how many testRuns run concurrently. 0 or 1 means
+	// testRuns run sequentially, same as before.
+	Parallelism int
+	// ResourceGroups maps a group name to the set of tool names and
+	// infrastructure compose files that must not run concurrently, e.g.
+	// because they bind the same host port. A testRun acquires every group
+	// its tool or any of its infrastructure compose files belongs to before
+	// starting.
+	ResourceGroups map[string][]string
 }
 
 func Run(ctx context.Context, cfg config.Config, opt RunOptions) error {
@@ -52,17 +76,131 @@ func Run(ctx context.Context, cfg config.Config, opt RunOptions) error {
 	testRuns := buildTestRuns(cfg, opt)
 	slog.Info("Identified tests", "count", len(testRuns))
 
+	groups := newResourceGroups(opt.ResourceGroups)
+	groups.addSharedMetricsGroups(testRuns)
+	parallelism := opt.Parallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var resultsMu sync.Mutex
+	var runResults []*results.Run
+
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithMaxGoroutines(parallelism)
 	for i, tr := range testRuns {
-		fmt.Println()
-		err = tr.Run(ctx)
+		p.Go(func(ctx context.Context) error {
+			unlock := groups.lock(tr.resourceMembers())
+			defer unlock()
+
+			fmt.Println()
+			if err := tr.Run(ctx); err != nil {
+				return fmt.Errorf("failed to run test %d (%s): %w", i, tr.Tool, err)
+			}
+
+			if tr.Results != nil {
+				resultsMu.Lock()
+				runResults = append(runResults, tr.Results)
+				resultsMu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := p.Wait(); err != nil {
+		return err
+	}
+
+	summaries := results.BuildSummaries(runResults)
+	if err := results.WriteSummary(opt.OutPath, summaries); err != nil {
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+
+	if opt.CompareWith != "" {
+		baseline, err := results.LoadBaseline(opt.CompareWith)
 		if err != nil {
-			return fmt.Errorf("failed to run test %d (%s): %w", i, tr.Tool, err)
+			return fmt.Errorf("failed to load baseline: %w", err)
+		}
+		for _, d := range results.Compare(baseline, summaries) {
+			fmt.Printf("%s/%s %s: avg %+.1f%%, p95 %+.1f%%, p99 %+.1f%%\n",
+				d.Test, d.Tool, d.Series, d.PercentChange.Avg, d.PercentChange.P95, d.PercentChange.P99)
 		}
 	}
 
 	return nil
 }
 
+// resourceGroups enforces mutual exclusion between testRuns whose tool or
+// infrastructure compose files belong to the same resource group, e.g.
+// because they bind the same host port.
+type resourceGroups struct {
+	members map[string][]string // group name -> tool names/compose files in it
+	locks   map[string]*sync.Mutex
+}
+
+func newResourceGroups(cfg map[string][]string) *resourceGroups {
+	groups := &resourceGroups{members: map[string][]string{}, locks: map[string]*sync.Mutex{}}
+	for name, members := range cfg {
+		groups.members[name] = members
+		groups.locks[name] = &sync.Mutex{}
+	}
+	return groups
+}
+
+// addSharedMetricsGroups adds a synthetic resource group for every distinct
+// metrics collector instance used by more than one testRun. cfg-level
+// collectors are built once and reused across every tool for every test, and
+// test-level collectors are reused across every tool for that test, so two
+// testRuns sharing a collector must not call its Start/Stop/Samples
+// concurrently, even if they happen to share a tool name across tests.
+func (g *resourceGroups) addSharedMetricsGroups(testRuns []*testRun) {
+	runsByCollector := map[config.MetricsCollector]map[string]bool{}
+	for _, tr := range testRuns {
+		for _, m := range tr.Metrics {
+			if runsByCollector[m] == nil {
+				runsByCollector[m] = map[string]bool{}
+			}
+			runsByCollector[m][tr.id()] = true
+		}
+	}
+
+	i := 0
+	for _, runs := range runsByCollector {
+		if len(runs) < 2 {
+			continue // only one testRun uses this collector, no exclusion needed
+		}
+
+		name := fmt.Sprintf("auto-shared-metrics-%d", i)
+		i++
+		g.members[name] = slices.Collect(maps.Keys(runs))
+		g.locks[name] = &sync.Mutex{}
+	}
+}
+
+// lock locks every resource group that any of members belongs to, in a
+// stable order to avoid deadlocks between testRuns that share more than one
+// group, and returns a function that unlocks them.
+func (g *resourceGroups) lock(members []string) func() {
+	var names []string
+	for name, groupMembers := range g.members {
+		for _, m := range members {
+			if slices.Contains(groupMembers, m) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.locks[name].Lock()
+	}
+
+	return func() {
+		for _, name := range slices.Backward(names) {
+			g.locks[name].Unlock()
+		}
+	}
+}
+
 func buildTestRuns(cfg config.Config, opt RunOptions) []*testRun {
 	now := time.Now()
 	runs := make([]*testRun, 0, len(cfg.Tests)*len(cfg.Tools))
@@ -103,6 +241,8 @@ func buildTestRuns(cfg config.Config, opt RunOptions) []*testRun {
 				}
 			}
 
+			outPath := filepath.Join(opt.OutPath, fmt.Sprintf("%s_%s_%s", now.Format("20060102150405"), t.Name, tool))
+
 			runs = append(runs, &testRun{
 				Infrastructure: infra,
 				Tools:          tools,
@@ -111,10 +251,13 @@ func buildTestRuns(cfg config.Config, opt RunOptions) []*testRun {
 				Name:     t.Name,
 				Duration: t.Duration,
 				Steps:    t.Steps,
+				During:   t.Steps.During,
 
 				Tool:         tool,
-				OutPath:      filepath.Join(opt.OutPath, fmt.Sprintf("%s_%s_%s", now.Format("20060102150405"), t.Name, tool)),
+				OutPath:      outPath,
+				Project:      sanitizeComposeProject(filepath.Base(outPath)),
 				DockerClient: opt.DockerClient,
+				TailOnError:  opt.TailOnError,
 			})
 		}
 	}
@@ -131,14 +274,22 @@ type testRun struct {
 	Name     string
 	Duration time.Duration
 	Steps    config.TestSteps
+	During   []config.Action
 
 	Tool         string // Tool is the name of the tool to run the test with
 	OutPath      string // OutPath is the directory where the test results are stored
+	Project      string // Project is the Docker Compose project name this run's containers are isolated under
 	DockerClient client.APIClient
+	TailOnError  int // TailOnError is the number of lines tailed into the test run's logger when a step fails
+
+	// Results is the canonical record of this run, populated by postTest.
+	Results *results.Run
 
+	startedAt         time.Time
 	cleanupFns        []func(context.Context) error
 	goroutinePool     *pool.ContextPool
 	goroutinePoolDead *atomic.Bool
+	containerLogs     []*containerlog.Capture
 }
 
 func (r *testRun) Run(ctx context.Context) (err error) {
@@ -152,6 +303,8 @@ func (r *testRun) Run(ctx context.Context) (err error) {
 		return fmt.Errorf("failed to create output folder %q: %w", r.OutPath, err)
 	}
 
+	r.startedAt = time.Now()
+
 	r.goroutinePool = pool.New().WithContext(ctx).WithCancelOnError()
 	r.goroutinePoolDead = &atomic.Bool{}
 
@@ -194,6 +347,7 @@ func (r *testRun) Run(ctx context.Context) (err error) {
 	for _, step := range steps {
 		if err := step(ctx); err != nil {
 			logger.Error("Test stopped because of an error", "error", err)
+			r.tailContainerLogs(logger)
 			return err
 		}
 	}
@@ -247,8 +401,19 @@ func (r *testRun) preTool(ctx context.Context) (err error) {
 	logger, lastLog := r.loggerForStep("pre-tool")
 	defer func() { lastLog(err) }()
 
-	_ = logger
+	for _, m := range r.Metrics {
+		if dc, ok := m.(interface{ SetDockerClient(client.APIClient) }); ok {
+			dc.SetDockerClient(r.DockerClient)
+		}
+		if err := m.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start metrics collector %s: %w", m.Type(), err)
+		}
+		// Stop in cleanup too, so a collector started here still gets
+		// stopped if a later step fails before postTest runs.
+		r.cleanupFns = append(r.cleanupFns, m.Stop)
+	}
 
+	logger.Info("Metrics collectors started", "count", len(r.Metrics))
 	return nil
 }
 
@@ -299,21 +464,42 @@ func (r *testRun) test(ctx context.Context) (err error) {
 
 	const timeBetweenLogs = 5 * time.Second
 
-	endTestAt := time.Now().Add(r.Duration + 500*time.Millisecond) // Add 500ms to account for time drift and nicer log output
-	testCompleted := time.After(r.Duration)
+	start := time.Now()
+	endTestAt := start.Add(r.Duration + 500*time.Millisecond) // Add 500ms to account for time drift and nicer log output
+	testCompleted := time.NewTimer(r.Duration)
+	defer testCompleted.Stop()
 	logTicker := time.NewTicker(timeBetweenLogs)
 	defer logTicker.Stop()
 
-	// TODO during
+	firings := newActionFirings(r.During, start, r.Tool)
 
 	for {
+		var firingTimer *time.Timer
+		var firingC <-chan time.Time
+		if firings.Len() > 0 {
+			firingTimer = time.NewTimer(time.Until((*firings)[0].at))
+			firingC = firingTimer.C
+		}
+
 		logger.Info("Test in progress", "time-left", endTestAt.Sub(time.Now()).Truncate(time.Second))
 		select {
 		case <-ctx.Done():
+			stopTimer(firingTimer)
 			return ctx.Err()
 		case <-logTicker.C:
+			stopTimer(firingTimer)
+			continue
+		case <-testCompleted.C:
+			stopTimer(firingTimer)
+		case <-firingC:
+			firing := heap.Pop(firings).(*actionFiring) //nolint:forcetypeassert // firings only ever holds *actionFiring.
+			r.Go(func(ctx context.Context) error {
+				return r.runAction(ctx, logger, firing.action)
+			})
+			if firing.action.Every > 0 {
+				heap.Push(firings, &actionFiring{action: firing.action, at: time.Now().Add(firing.action.Every)})
+			}
 			continue
-		case <-testCompleted:
 		}
 		break
 	}
@@ -321,12 +507,121 @@ func (r *testRun) test(ctx context.Context) (err error) {
 	return nil
 }
 
+// actionFiring is a single scheduled firing of a during-test action.
+type actionFiring struct {
+	action *config.Action
+	at     time.Time
+}
+
+// actionFirings is a min-heap of upcoming action firings, ordered by time.
+type actionFirings []*actionFiring
+
+func (h actionFirings) Len() int           { return len(h) }
+func (h actionFirings) Less(i, j int) bool { return h[i].at.Before(h[j].at) }
+func (h actionFirings) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *actionFirings) Push(x any) {
+	*h = append(*h, x.(*actionFiring)) //nolint:forcetypeassert // container/heap always pushes *actionFiring.
+}
+
+func (h *actionFirings) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// newActionFirings builds the initial heap of action firings for tool,
+// scheduling RunAt actions once at their absolute offset and Every actions
+// at their first tick. An action whose Tool is set and doesn't match tool is
+// skipped, since During is shared by every tool running the same test.
+func newActionFirings(actions []config.Action, start time.Time, tool string) *actionFirings {
+	firings := make(actionFirings, 0, len(actions))
+	for i := range actions {
+		a := &actions[i]
+		if a.Tool != "" && a.Tool != tool {
+			continue
+		}
+		switch {
+		case a.RunAt > 0:
+			firings = append(firings, &actionFiring{action: a, at: start.Add(a.RunAt)})
+		case a.Every > 0:
+			firings = append(firings, &actionFiring{action: a, at: start.Add(a.Every)})
+		}
+	}
+	heap.Init(&firings)
+	return &firings
+}
+
+// runAction execs the action's command in its target container and appends
+// its combined stdout/stderr to <OutPath>/during_<name>.log.
+func (r *testRun) runAction(ctx context.Context, logger *slog.Logger, a *config.Action) error {
+	logPath := filepath.Join(r.OutPath, fmt.Sprintf("during_%s.log", a.Name))
+	f, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file for action %s: %w", a.Name, err)
+	}
+	defer f.Close()
+
+	execID, err := r.DockerClient.ContainerExecCreate(ctx, a.Container, container.ExecOptions{
+		Cmd:          a.Command,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create exec for action %s: %w", a.Name, err)
+	}
+
+	resp, err := r.DockerClient.ContainerExecAttach(ctx, execID.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to attach exec for action %s: %w", a.Name, err)
+	}
+	defer resp.Close()
+
+	if err := containerlog.Demux(f, resp.Reader); err != nil {
+		return fmt.Errorf("failed to capture output for action %s: %w", a.Name, err)
+	}
+
+	logger.Info("Action executed", "action", a.Name, "container", a.Container)
+	return nil
+}
+
+func stopTimer(t *time.Timer) {
+	if t != nil {
+		t.Stop()
+	}
+}
+
 func (r *testRun) postTest(ctx context.Context) (err error) {
 	logger, lastLog := r.loggerForStep("post-test")
 	defer func() { lastLog(err) }()
 
-	_ = logger
+	var providers []results.SeriesProvider
+	for _, m := range r.Metrics {
+		if err := m.Stop(ctx); err != nil {
+			logger.Error("Failed to stop metrics collector", "type", m.Type(), "error", err)
+		}
 
+		p, ok := m.(results.SeriesProvider)
+		if !ok {
+			continue
+		}
+		providers = append(providers, p)
+	}
+
+	run, err := results.Write(r.OutPath, results.Meta{
+		Test:      r.Name,
+		Tool:      r.Tool,
+		StartedAt: r.startedAt,
+		Duration:  r.Duration,
+	}, providers)
+	if err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	r.Results = run
+
+	logger.Info("Results written", "series", len(run.Series))
 	return nil
 }
 
@@ -371,6 +666,23 @@ func (r *testRun) collectDockerComposeFiles(cfgs []config.ServiceConfig) []strin
 	return paths
 }
 
+// resourceMembers returns the identifiers resourceGroups matches this
+// testRun against: its tool name and every infrastructure compose file it
+// depends on.
+func (r *testRun) resourceMembers() []string {
+	members := make([]string, 0, 2+len(r.Infrastructure))
+	members = append(members, r.Tool, r.id())
+	members = append(members, r.collectDockerComposeFiles(r.Infrastructure)...)
+	return members
+}
+
+// id uniquely identifies this testRun among every testRun built for a single
+// Run, for auto-derived resource groups that must target one specific
+// testRun rather than every testRun for a tool name.
+func (r *testRun) id() string {
+	return r.Name + "/" + r.Tool
+}
+
 // Go spawns a goroutine in the goroutine pool that runs the given function.
 // If the function returns an error, the goroutine pool is marked dead.
 func (r *testRun) Go(f func(ctx context.Context) error) {
@@ -420,9 +732,10 @@ func (r *testRun) dockerComposeUpWait(
 		return dockerutil.ComposeUp(
 			ctx,
 			dockerutil.ComposeOptions{
-				File:   dockerComposeFiles,
-				Stdout: f,
-				Stderr: f,
+				Project: r.Project,
+				File:    dockerComposeFiles,
+				Stdout:  f,
+				Stderr:  f,
 			},
 			dockerutil.ComposeUpOptions{},
 		)
@@ -433,7 +746,8 @@ func (r *testRun) dockerComposeUpWait(
 		return dockerutil.ComposeDown(
 			ctx,
 			dockerutil.ComposeOptions{
-				File: dockerComposeFiles,
+				Project: r.Project,
+				File:    dockerComposeFiles,
 			},
 			dockerutil.ComposeDownOptions{},
 		)
@@ -453,8 +767,9 @@ func (r *testRun) dockerComposeUpWait(
 		err = dockerutil.ComposePs(
 			ctx,
 			dockerutil.ComposeOptions{
-				File:   dockerComposeFiles,
-				Stdout: &buf,
+				Project: r.Project,
+				File:    dockerComposeFiles,
+				Stdout:  &buf,
 			},
 			dockerutil.ComposePsOptions{
 				Quiet: ptr(true),
@@ -469,11 +784,23 @@ func (r *testRun) dockerComposeUpWait(
 		}
 	}
 
+	// ComposePs can return containers from other projects sharing the same
+	// compose files on a misbehaving Compose version, so narrow the list down
+	// to this run's own project via its label before waiting on any of them.
+	containers, err = r.filterOwnContainers(ctx, containers)
+	if err != nil {
+		return fmt.Errorf("failed to filter containers: %w", err)
+	}
+
 	logger.Info(fmt.Sprintf("Identified %d containers", len(containers)))
 	if r.goroutinePoolDead.Load() {
 		return errors.New("failed to start containers")
 	}
 
+	if err := r.startContainerLogs(ctx, logger, containers); err != nil {
+		return fmt.Errorf("failed to start container log capture: %w", err)
+	}
+
 	wg := pool.New().WithErrors()
 	for _, c := range containers {
 		wg.Go(func() error {
@@ -512,6 +839,95 @@ func (r *testRun) dockerComposeUpWait(
 	return nil
 }
 
+// startContainerLogs opens a per-container log capture for each given
+// container and launches it through the goroutine pool, so a stream failure
+// marks the pool dead just like any other background task. Log files are
+// named after the container's name, not its (opaque) ID, so it's obvious
+// which service produced which line.
+func (r *testRun) startContainerLogs(ctx context.Context, logger *slog.Logger, containers []string) error {
+	logsDir := filepath.Join(r.OutPath, containerlog.Dir)
+	if err := os.MkdirAll(logsDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create container log directory: %w", err)
+	}
+
+	for _, c := range containers {
+		resp, err := r.DockerClient.ContainerInspect(ctx, c)
+		if err != nil {
+			return fmt.Errorf("failed to inspect container %s: %w", c, err)
+		}
+		name := strings.TrimPrefix(resp.Name, "/")
+
+		cap, err := containerlog.New(r.DockerClient, c, name, logsDir)
+		if err != nil {
+			return fmt.Errorf("failed to create log capture for %s: %w", name, err)
+		}
+
+		r.containerLogs = append(r.containerLogs, cap)
+		r.cleanupFns = append(r.cleanupFns, func(ctx context.Context) error {
+			return cap.Close()
+		})
+		r.Go(cap.Run)
+	}
+
+	logger.Info("Started container log capture", "containers", len(containers))
+	return nil
+}
+
+// tailContainerLogs copies the last TailOnError lines of each captured
+// container log into the given logger, for fast triage when a step fails.
+func (r *testRun) tailContainerLogs(logger *slog.Logger) {
+	if r.TailOnError <= 0 {
+		return
+	}
+
+	for _, cap := range r.containerLogs {
+		lines, err := cap.Tail(r.TailOnError)
+		if err != nil {
+			logger.Warn("Failed to tail container log", "container", cap.Name, "error", err)
+			continue
+		}
+		logger.Error("Container log tail", "container", cap.Name, "tail", strings.Join(lines, "\n"))
+	}
+}
+
+// filterOwnContainers keeps only the containers in ids that carry this run's
+// Compose project label, so concurrent testRuns sharing compose files don't
+// wait on each other's containers.
+func (r *testRun) filterOwnContainers(ctx context.Context, ids []string) ([]string, error) {
+	list, err := r.DockerClient.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", "com.docker.compose.project="+r.Project)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	owned := make(map[string]bool, len(list))
+	for _, c := range list {
+		owned[c.ID] = true
+		for _, n := range c.Names {
+			owned[strings.TrimPrefix(n, "/")] = true
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return !owned[id] }), nil
+}
+
+// sanitizeComposeProject turns s into a valid Docker Compose project name
+// (lowercase alphanumerics, '-' and '_' only).
+func sanitizeComposeProject(s string) string {
+	s = strings.ToLower(s)
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	return b.String()
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
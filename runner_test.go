@@ -0,0 +1,101 @@
+// Copyright © 2025 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchi
+
+import (
+	"container/heap"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/conduitio/benchi/config"
+)
+
+func TestNewActionFirings_FiltersByTool(t *testing.T) {
+	start := time.Now()
+	actions := []config.Action{
+		{Name: "a", Tool: "kafka", RunAt: time.Second},
+		{Name: "b", Tool: "redis", RunAt: time.Second},
+		{Name: "c", RunAt: time.Second}, // no Tool: fires for every tool
+	}
+
+	firings := newActionFirings(actions, start, "kafka")
+
+	var names []string
+	for _, f := range *firings {
+		names = append(names, f.action.Name)
+	}
+	slices.Sort(names)
+
+	if got, want := names, []string{"a", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestNewActionFirings_Every(t *testing.T) {
+	start := time.Now()
+	actions := []config.Action{{Name: "a", Every: time.Second}}
+
+	firings := newActionFirings(actions, start, "kafka")
+
+	if firings.Len() != 1 {
+		t.Fatalf("got %d firings, want 1", firings.Len())
+	}
+	if at := (*firings)[0].at; at.Before(start) || at.After(start.Add(2*time.Second)) {
+		t.Fatalf("first Every firing at %v, want around %v", at, start.Add(time.Second))
+	}
+}
+
+func TestSanitizeComposeProject(t *testing.T) {
+	tests := map[string]string{
+		"already-valid_123": "already-valid_123",
+		"My Test Run":       "my-test-run",
+		"Run #1 (final)":    "run--1--final-",
+	}
+
+	for in, want := range tests {
+		if got := sanitizeComposeProject(in); got != want {
+			t.Errorf("sanitizeComposeProject(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestActionFirings_FireInTimeOrder(t *testing.T) {
+	start := time.Now()
+	later := &config.Action{Name: "later"}
+	sooner := &config.Action{Name: "sooner"}
+
+	h := actionFirings{
+		{action: later, at: start.Add(2 * time.Second)},
+		{action: sooner, at: start.Add(time.Second)},
+	}
+	heap.Init(&h)
+
+	first, ok := heap.Pop(&h).(*actionFiring)
+	if !ok {
+		t.Fatal("heap.Pop did not return an *actionFiring")
+	}
+	if first.action.Name != "sooner" {
+		t.Fatalf("expected %q to fire first, got %q", "sooner", first.action.Name)
+	}
+
+	second, ok := heap.Pop(&h).(*actionFiring)
+	if !ok {
+		t.Fatal("heap.Pop did not return an *actionFiring")
+	}
+	if second.action.Name != "later" {
+		t.Fatalf("expected %q to fire second, got %q", "later", second.action.Name)
+	}
+}